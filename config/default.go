@@ -1,7 +1,52 @@
 package config
 
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
 type Config struct {
 	DBUri    string `mapstructure:"MONGODB_LOCAL_URI"`
 	RedisUri string `mapstruture:"REDIS_URL"`
 	Port     string `mapstructure:"PORT"`
+	GRPCPort string `mapstructure:"GRPC_PORT"`
+
+	// AppBaseURL is the externally reachable scheme+host (e.g.
+	// "https://api.example.com") links in outbound emails are built from,
+	// since the Gin routes are only ever mounted under "/api".
+	AppBaseURL string `mapstructure:"APP_BASE_URL"`
+
+	SMTPHost string `mapstructure:"SMTP_HOST"`
+	SMTPPort int    `mapstructure:"SMTP_PORT"`
+	SMTPUser string `mapstructure:"SMTP_USER"`
+	SMTPPass string `mapstructure:"SMTP_PASS"`
+	SMTPFrom string `mapstructure:"SMTP_FROM"`
+
+	AccessTokenPrivateKey  string        `mapstructure:"ACCESS_TOKEN_PRIVATE_KEY"`
+	AccessTokenPublicKey   string        `mapstructure:"ACCESS_TOKEN_PUBLIC_KEY"`
+	RefreshTokenPrivateKey string        `mapstructure:"REFRESH_TOKEN_PRIVATE_KEY"`
+	RefreshTokenPublicKey  string        `mapstructure:"REFRESH_TOKEN_PUBLIC_KEY"`
+	AccessTokenExpiredIn   time.Duration `mapstructure:"ACCESS_TOKEN_EXPIRED_IN"`
+	RefreshTokenExpiredIn  time.Duration `mapstructure:"REFRESH_TOKEN_EXPIRED_IN"`
+	AccessTokenMaxAge      int           `mapstructure:"ACCESS_TOKEN_MAXAGE"`
+	RefreshTokenMaxAge     int           `mapstructure:"REFRESH_TOKEN_MAXAGE"`
+}
+
+// LoadConfig reads the ".env"-style config file named "app" from path, with
+// environment variables taking precedence, into a Config, the same way for
+// every caller across the Gin and gRPC entry points. It returns an error if
+// that file cannot be read.
+func LoadConfig(path string) (config Config, err error) {
+	viper.AddConfigPath(path)
+	viper.SetConfigType("env")
+	viper.SetConfigName("app")
+	viper.AutomaticEnv()
+
+	if err = viper.ReadInConfig(); err != nil {
+		return
+	}
+
+	err = viper.Unmarshal(&config)
+	return
 }
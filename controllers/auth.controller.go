@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	config2 "github.com/SarathLUN/auth-service-grpc-golang/config"
 	"github.com/SarathLUN/auth-service-grpc-golang/models"
@@ -10,9 +11,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/mongo"
 	"net/http"
-	"strings"
+	"time"
 )
 
+// reauthTokenExpiredIn is how long a reauth_token stays valid once minted by
+// Reauthenticate, per the short-lived "step-up" window gated routes require.
+const reauthTokenExpiredIn = 5 * time.Minute
+
 type AuthController struct {
 	authService services.AuthService
 	userService services.UserService
@@ -36,7 +41,7 @@ func (ac *AuthController) SignUpUser(ctx *gin.Context) {
 
 	newUser, err := ac.authService.SignUpUser(user)
 	if err != nil {
-		if strings.Contains(err.Error(), "email already exist") {
+		if errors.Is(err, services.ErrEmailExists) {
 			ctx.JSON(http.StatusConflict, gin.H{"status": "error", "message": err.Error()})
 			return
 		}
@@ -52,7 +57,7 @@ func (ac *AuthController) SignInUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
 		return
 	}
-	user, err := ac.userService.FindUserByEmail(credentials.Email)
+	user, err := ac.userService.FindUserByEmailOrUsername(credentials.Identifier)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": "Invalid email or password"})
@@ -67,20 +72,30 @@ func (ac *AuthController) SignInUser(ctx *gin.Context) {
 		return
 	}
 
+	if !user.Verified {
+		ctx.JSON(http.StatusForbidden, gin.H{"status": "fail", "message": "please verify your email before logging in"})
+		return
+	}
+
 	config, _ := config2.LoadConfig(".")
 	// Generate Tokens
-	accessTokens, err := utils.CreateToken(config.AccessTokenExpiredIn, user.ID, config.AccessTokenPrivateKey)
+	accessTokens, _, err := utils.CreateToken(config.AccessTokenExpiredIn, user.ID, config.AccessTokenPrivateKey)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
 		return
 	}
 
-	refreshTokens, err := utils.CreateToken(config.RefreshTokenExpiredIn, user.ID, config.RefreshTokenPrivateKey)
+	refreshTokens, refreshJti, err := utils.CreateToken(config.RefreshTokenExpiredIn, user.ID, config.RefreshTokenPrivateKey)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
 		return
 	}
 
+	if err := ac.authService.StoreRefreshToken(user.ID.Hex(), refreshJti, config.RefreshTokenExpiredIn); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "error", "message": err.Error()})
+		return
+	}
+
 	ctx.SetCookie("access_token", accessTokens, config.AccessTokenMaxAge*60, "/", "localhost", false, true)
 	ctx.SetCookie("refresh_token", refreshTokens, config.RefreshTokenMaxAge*60, "/", "localhost", false, true)
 	ctx.SetCookie("logged_in", "true", config.AccessTokenMaxAge*60, "/", "localhost", false, false)
@@ -95,22 +110,188 @@ func (ac *AuthController) RefreshAccessToken(ctx *gin.Context) {
 		return
 	}
 	config, _ := config2.LoadConfig(".")
-	sub, err := utils.ValidateToken(cookie, config.RefreshTokenPublicKey)
+	sub, jti, err := utils.ValidateToken(cookie, config.RefreshTokenPublicKey)
 	if err != nil {
 		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": err.Error()})
 		return
 	}
+
+	valid, err := ac.authService.IsRefreshTokenValid(fmt.Sprint(sub), jti)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+	if !valid {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": message})
+		return
+	}
+
 	user, err := ac.userService.FindUserById(fmt.Sprint(sub))
 	if err != nil {
 		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": "the user belonging to this token no longer exists"})
 		return
 	}
-	accessToken, err := utils.CreateToken(config.AccessTokenExpiredIn, user.ID, config.AccessTokenPrivateKey)
+	accessToken, _, err := utils.CreateToken(config.AccessTokenExpiredIn, user.ID, config.AccessTokenPrivateKey)
 	if err != nil {
 		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": err.Error()})
 		return
 	}
+
+	// rotate the refresh token so the presented jti can only be used once
+	newRefreshToken, newJti, err := utils.CreateToken(config.RefreshTokenExpiredIn, user.ID, config.RefreshTokenPrivateKey)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+	if err := ac.authService.StoreRefreshToken(user.ID.Hex(), newJti, config.RefreshTokenExpiredIn); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+	if err := ac.authService.RevokeRefreshToken(fmt.Sprint(sub), jti); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
 	ctx.SetCookie("access_token", accessToken, config.AccessTokenMaxAge*60, "/", "localhost", false, true)
+	ctx.SetCookie("refresh_token", newRefreshToken, config.RefreshTokenMaxAge*60, "/", "localhost", false, true)
 	ctx.SetCookie("logged_in", "true", config.AccessTokenMaxAge*60, "/", "localhost", false, false)
 	ctx.JSON(http.StatusOK, gin.H{"status": "success", "access_token": accessToken})
 }
+
+// Reauthenticate confirms the caller's current password and, on success,
+// mints a short-lived reauth_token cookie that middleware.RequireReauth
+// checks for in front of high-risk routes.
+func (ac *AuthController) Reauthenticate(ctx *gin.Context) {
+	currentUser := ctx.MustGet("currentUser").(*models.DBResponse)
+
+	var payload struct {
+		Password string `json:"password" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if err := utils.VerifyPassword(currentUser.Password, payload.Password); err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"status": "fail", "message": "incorrect password"})
+		return
+	}
+
+	config, _ := config2.LoadConfig(".")
+	reauthToken, err := utils.CreateReauthToken(reauthTokenExpiredIn, currentUser.ID, config.AccessTokenPrivateKey)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "error", "message": err.Error()})
+		return
+	}
+
+	ctx.SetCookie("reauth_token", reauthToken, int(reauthTokenExpiredIn.Seconds()), "/", "localhost", false, true)
+	ctx.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+// VerifyEmail flips the Verified flag for the user the code was issued to
+// and consumes the code so it cannot be reused.
+func (ac *AuthController) VerifyEmail(ctx *gin.Context) {
+	code := ctx.Param("code")
+
+	userID, err := ac.authService.ConsumeVerificationCode(code)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if err := ac.userService.MarkUserVerified(userID); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "error", "message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success", "message": "email verified"})
+}
+
+// ResendVerification re-sends a verification email for an unverified
+// account, rate-limited per user so it can't be used to spam a mailbox.
+func (ac *AuthController) ResendVerification(ctx *gin.Context) {
+	var payload struct {
+		Email string `json:"email" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	user, err := ac.userService.FindUserByEmail(payload.Email)
+	if err != nil || user.Verified {
+		// Don't reveal whether the email exists or is already verified.
+		ctx.JSON(http.StatusOK, gin.H{"status": "success"})
+		return
+	}
+
+	allowed, err := ac.authService.AllowResendVerification(user.ID.Hex())
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "error", "message": err.Error()})
+		return
+	}
+	if !allowed {
+		ctx.JSON(http.StatusTooManyRequests, gin.H{"status": "fail", "message": "please wait before requesting another verification email"})
+		return
+	}
+
+	if err := ac.authService.SendVerificationEmail(user.ID.Hex(), user.Email, user.Name); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "error", "message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+// ChangePassword rehashes the caller's password after confirming the
+// current one, then revokes every outstanding refresh token for the account
+// so other sessions must sign in again.
+func (ac *AuthController) ChangePassword(ctx *gin.Context) {
+	currentUser := ctx.MustGet("currentUser").(*models.DBResponse)
+
+	var payload struct {
+		CurrentPassword string `json:"current_password" binding:"required"`
+		NewPassword     string `json:"new_password" binding:"required,min=8"`
+	}
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"status": "fail", "message": err.Error()})
+		return
+	}
+
+	if err := utils.VerifyPassword(currentUser.Password, payload.CurrentPassword); err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"status": "fail", "message": "incorrect current password"})
+		return
+	}
+
+	hashedPassword, err := utils.HashPassword(payload.NewPassword)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "error", "message": err.Error()})
+		return
+	}
+
+	if err := ac.userService.UpdatePassword(currentUser.ID.Hex(), hashedPassword); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "error", "message": err.Error()})
+		return
+	}
+
+	if err := ac.authService.RevokeAllRefreshTokens(currentUser.ID.Hex()); err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"status": "error", "message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (ac *AuthController) LogoutUser(ctx *gin.Context) {
+	if cookie, err := ctx.Cookie("refresh_token"); err == nil {
+		config, _ := config2.LoadConfig(".")
+		if sub, jti, err := utils.ValidateToken(cookie, config.RefreshTokenPublicKey); err == nil {
+			_ = ac.authService.RevokeRefreshToken(fmt.Sprint(sub), jti)
+		}
+	}
+
+	ctx.SetCookie("access_token", "", -1, "/", "localhost", false, true)
+	ctx.SetCookie("refresh_token", "", -1, "/", "localhost", false, true)
+	ctx.SetCookie("logged_in", "", -1, "/", "localhost", false, false)
+	ctx.JSON(http.StatusOK, gin.H{"status": "success"})
+}
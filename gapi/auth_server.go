@@ -0,0 +1,175 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SarathLUN/auth-service-grpc-golang/models"
+	pb "github.com/SarathLUN/auth-service-grpc-golang/proto"
+	"github.com/SarathLUN/auth-service-grpc-golang/services"
+	"github.com/SarathLUN/auth-service-grpc-golang/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuthServer exposes AuthService/UserService over gRPC, delegating every RPC
+// to the same service implementations the Gin controllers use so the
+// business logic isn't duplicated.
+type AuthServer struct {
+	pb.UnimplementedAuthServiceServer
+	authService services.AuthService
+	userService services.UserService
+}
+
+func NewAuthServer(authService services.AuthService, userService services.UserService) *AuthServer {
+	return &AuthServer{
+		authService: authService,
+		userService: userService,
+	}
+}
+
+func (s *AuthServer) SignUpUser(ctx context.Context, req *pb.SignUpUserInput) (*pb.SignUpUserResponse, error) {
+	user := &models.SignUpInput{
+		Name:            req.GetName(),
+		Email:           req.GetEmail(),
+		Password:        req.GetPassword(),
+		ConfirmPassword: req.GetPasswordConfirm(),
+	}
+
+	if user.Password != user.ConfirmPassword {
+		return nil, status.Error(codes.InvalidArgument, "passwords do not match")
+	}
+
+	newUser, err := s.authService.SignUpUser(user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not sign up user: %v", err)
+	}
+
+	return &pb.SignUpUserResponse{User: dbResponseToPB(newUser)}, nil
+}
+
+func (s *AuthServer) SignInUser(ctx context.Context, req *pb.SignInUserInput) (*pb.SignInUserResponse, error) {
+	user, err := s.userService.FindUserByEmailOrUsername(req.GetIdentifier())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "invalid identifier or password")
+	}
+
+	if err := utils.VerifyPassword(user.Password, req.GetPassword()); err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid identifier or password")
+	}
+
+	if !user.Verified {
+		return nil, status.Error(codes.PermissionDenied, "please verify your email before logging in")
+	}
+
+	accessToken, refreshToken, err := s.mintTokenPair(user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not sign in user: %v", err)
+	}
+
+	return &pb.SignInUserResponse{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+func (s *AuthServer) RefreshAccessToken(ctx context.Context, req *pb.RefreshAccessTokenInput) (*pb.RefreshAccessTokenResponse, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not load config: %v", err)
+	}
+
+	sub, jti, err := utils.ValidateToken(req.GetRefreshToken(), config.RefreshTokenPublicKey)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "could not refresh access token")
+	}
+
+	valid, err := s.authService.IsRefreshTokenValid(fmt.Sprint(sub), jti)
+	if err != nil || !valid {
+		return nil, status.Error(codes.Unauthenticated, "could not refresh access token")
+	}
+
+	user, err := s.userService.FindUserById(fmt.Sprint(sub))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "the user belonging to this token no longer exists")
+	}
+
+	accessToken, newRefreshToken, err := s.mintTokenPair(user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not refresh access token: %v", err)
+	}
+	if err := s.authService.RevokeRefreshToken(fmt.Sprint(sub), jti); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not refresh access token: %v", err)
+	}
+
+	return &pb.RefreshAccessTokenResponse{AccessToken: accessToken, RefreshToken: newRefreshToken}, nil
+}
+
+func (s *AuthServer) VerifyEmail(ctx context.Context, req *pb.VerifyEmailInput) (*pb.VerifyEmailResponse, error) {
+	userID, err := s.authService.ConsumeVerificationCode(req.GetCode())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired verification code")
+	}
+
+	if err := s.userService.MarkUserVerified(userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not verify email: %v", err)
+	}
+
+	return &pb.VerifyEmailResponse{Verified: true}, nil
+}
+
+func (s *AuthServer) GetMe(ctx context.Context, req *pb.GetMeInput) (*pb.GetMeResponse, error) {
+	currentUser, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetMeResponse{User: dbResponseToPB(currentUser)}, nil
+}
+
+func (s *AuthServer) Logout(ctx context.Context, req *pb.LogoutInput) (*pb.LogoutResponse, error) {
+	currentUser, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.RevokeAllRefreshTokens(currentUser.ID.Hex()); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not log out: %v", err)
+	}
+
+	return &pb.LogoutResponse{Success: true}, nil
+}
+
+// mintTokenPair creates a fresh access/refresh token pair for user and
+// records the refresh token's jti so it can be validated or revoked later.
+func (s *AuthServer) mintTokenPair(user *models.DBResponse) (string, string, error) {
+	config, err := loadConfig()
+	if err != nil {
+		return "", "", err
+	}
+
+	accessToken, _, err := utils.CreateToken(config.AccessTokenExpiredIn, user.ID, config.AccessTokenPrivateKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, jti, err := utils.CreateToken(config.RefreshTokenExpiredIn, user.ID, config.RefreshTokenPrivateKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := s.authService.StoreRefreshToken(user.ID.Hex(), jti, config.RefreshTokenExpiredIn); err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+func dbResponseToPB(user *models.DBResponse) *pb.User {
+	return &pb.User{
+		Id:        user.ID.Hex(),
+		Name:      user.Name,
+		Email:     user.Email,
+		Role:      user.Role,
+		CreatedAt: timestamppb.New(user.CreateAt),
+		UpdatedAt: timestamppb.New(user.UpdatedAt),
+	}
+}
@@ -0,0 +1,71 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SarathLUN/auth-service-grpc-golang/config"
+	"github.com/SarathLUN/auth-service-grpc-golang/models"
+	"github.com/SarathLUN/auth-service-grpc-golang/services"
+	"github.com/SarathLUN/auth-service-grpc-golang/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type contextKey string
+
+const currentUserContextKey contextKey = "currentUser"
+
+// AuthUnaryInterceptor is the gRPC equivalent of middleware.DeserializeUser:
+// it reads the "authorization: Bearer <token>" metadata set by the client,
+// validates the access token, and stashes the resolved user on the context
+// so handlers like GetMe/Logout can read it back with userFromContext.
+func AuthUnaryInterceptor(userService services.UserService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			return handler(ctx, req)
+		}
+
+		var accessToken string
+		if _, err := fmt.Sscanf(values[0], "Bearer %s", &accessToken); err != nil {
+			return handler(ctx, req)
+		}
+
+		conf, err := config.LoadConfig(".")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not load config: %v", err)
+		}
+
+		sub, _, err := utils.ValidateToken(accessToken, conf.AccessTokenPublicKey)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		user, err := userService.FindUserById(fmt.Sprint(sub))
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "the user belonging to this token no longer exists")
+		}
+
+		return handler(context.WithValue(ctx, currentUserContextKey, user), req)
+	}
+}
+
+func userFromContext(ctx context.Context) (*models.DBResponse, error) {
+	user, ok := ctx.Value(currentUserContextKey).(*models.DBResponse)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "you are not logged in")
+	}
+	return user, nil
+}
+
+func loadConfig() (config.Config, error) {
+	return config.LoadConfig(".")
+}
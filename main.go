@@ -4,21 +4,35 @@ import (
 	"context"
 	"fmt"
 	"github.com/SarathLUN/auth-service-grpc-golang/config"
+	"github.com/SarathLUN/auth-service-grpc-golang/controllers"
+	"github.com/SarathLUN/auth-service-grpc-golang/gapi"
+	"github.com/SarathLUN/auth-service-grpc-golang/middleware"
+	pb "github.com/SarathLUN/auth-service-grpc-golang/proto"
+	"github.com/SarathLUN/auth-service-grpc-golang/routes"
+	"github.com/SarathLUN/auth-service-grpc-golang/services"
 	"github.com/gin-gonic/gin"
 	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"log"
+	"net"
 	"net/http"
 )
 
 // ? create variable that we'll re-assign later
 var (
-	server      *gin.Engine
-	ctx         context.Context
-	mongoClient *mongo.Client
-	redisClient *redis.Client
+	server              *gin.Engine
+	ctx                 context.Context
+	mongoClient         *mongo.Client
+	redisClient         *redis.Client
+	authCollection      *mongo.Collection
+	authService         services.AuthService
+	userService         services.UserService
+	authController      controllers.AuthController
+	userController      controllers.UserController
+	authRouteController routes.AuthRouteController
 )
 
 // init function that will run before `main` function
@@ -57,6 +71,17 @@ func init() {
 	}
 	fmt.Println("Redis client connect successful...")
 
+	// wire the services and controllers shared by the Gin and gRPC servers
+	authCollection = mongoClient.Database("golang_mongodb").Collection("users")
+	if err := services.EnsureIndexes(ctx, authCollection); err != nil {
+		log.Fatalln("could not create indexes: ", err)
+	}
+	authService = services.NewAuthService(authCollection, redisClient, services.NewSMTPMailer(config), ctx)
+	userService = services.NewUserService(authCollection, ctx)
+	authController = controllers.NewAuthController(authService, userService, ctx, authCollection)
+	userController = controllers.NewUserController(userService)
+	authRouteController = routes.NewAuthRouteController(authController)
+
 	// Create the Gin Engine instant
 	server = gin.Default()
 }
@@ -82,5 +107,31 @@ func main() {
 			"message": value,
 		})
 	})
+	router.GET("/users/me", middleware.DeserializeUser(userService), userController.GetMe)
+	authRouteController.AuthRoute(router, userService)
+	authRouteController.UserRoute(router, userService)
+
+	go runGrpcServer(config)
+
 	log.Fatalln(server.Run(":" + config.Port))
 }
+
+// runGrpcServer boots the gRPC counterpart of the Gin HTTP API on its own
+// port, wrapping the same AuthService/UserService implementations so
+// business logic isn't duplicated between the two transports.
+func runGrpcServer(config config.Config) {
+	authServer := gapi.NewAuthServer(authService, userService)
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(gapi.AuthUnaryInterceptor(userService)))
+	pb.RegisterAuthServiceServer(grpcServer, authServer)
+
+	listener, err := net.Listen("tcp", ":"+config.GRPCPort)
+	if err != nil {
+		log.Fatalln("could not listen on gRPC port: ", err)
+	}
+
+	fmt.Println("gRPC server started on port: ", config.GRPCPort)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalln("could not start gRPC server: ", err)
+	}
+}
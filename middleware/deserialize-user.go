@@ -29,7 +29,7 @@ func DeserializeUser(userService services.UserService) gin.HandlerFunc {
 		}
 
 		conf, _ := config.LoadConfig(".")
-		sub, err := utils.ValidateToken(accessToken, conf.AccessTokenPublicKey)
+		sub, _, err := utils.ValidateToken(accessToken, conf.AccessTokenPublicKey)
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"status": "fail", "message": err.Error()})
 			return
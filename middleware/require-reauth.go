@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/SarathLUN/auth-service-grpc-golang/config"
+	"github.com/SarathLUN/auth-service-grpc-golang/models"
+	"github.com/SarathLUN/auth-service-grpc-golang/services"
+	"github.com/SarathLUN/auth-service-grpc-golang/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireReauth guards sensitive actions (password change, email change,
+// account deletion, ...) behind a recent reauth_token cookie minted by
+// AuthController.Reauthenticate. It must run after DeserializeUser so
+// "currentUser" is already set. A stolen access token alone is then not
+// enough to mutate the account, since reauth_token is tagged with its own
+// claim that a regular access token doesn't carry.
+func RequireReauth(userService services.UserService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		currentUser := ctx.MustGet("currentUser").(*models.DBResponse)
+
+		cookie, err := ctx.Cookie("reauth_token")
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": "please reauthenticate to continue"})
+			return
+		}
+
+		conf, _ := config.LoadConfig(".")
+		sub, err := utils.ValidateReauthToken(cookie, conf.AccessTokenPublicKey)
+		if err != nil || fmt.Sprint(sub) != currentUser.ID.Hex() {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"status": "fail", "message": "please reauthenticate to continue"})
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -17,8 +17,8 @@ type SignUpInput struct {
 }
 
 type SignInInput struct {
-	Email    string `json:"email" bson:"email" binding:"required"`
-	Password string `json:"password" bson:"password" binding:"required"`
+	Identifier string `json:"identifier" bson:"identifier" binding:"required"`
+	Password   string `json:"password" bson:"password" binding:"required"`
 }
 
 type DBResponse struct {
@@ -0,0 +1,286 @@
+// Hand-maintained stand-in for the protoc-gen-go output of proto/auth.proto.
+//
+// `make proto` is the source of truth: once protoc/protoc-gen-go/
+// protoc-gen-go-grpc are available, regenerate with it and this file will
+// be overwritten with the real reflection-backed output (ProtoReflect,
+// the rawDesc file descriptor, registration, etc). Until then these types
+// only satisfy the legacy MessageV1 interface (Reset/String/ProtoMessage),
+// which is enough for gRPC's legacy marshaling path to (de)serialize them,
+// so the gRPC surface built on top of them compiles and runs.
+
+package proto
+
+import (
+	fmt "fmt"
+
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type User struct {
+	Id        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email     string               `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role      string               `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return fmt.Sprintf("%+v", *m) }
+func (*User) ProtoMessage()    {}
+
+func (m *User) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *User) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *User) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *User) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *User) GetCreatedAt() *timestamppb.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type SignUpUserInput struct {
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email           string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password        string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	PasswordConfirm string `protobuf:"bytes,4,opt,name=password_confirm,json=passwordConfirm,proto3" json:"password_confirm,omitempty"`
+}
+
+func (m *SignUpUserInput) Reset()         { *m = SignUpUserInput{} }
+func (m *SignUpUserInput) String() string { return fmt.Sprintf("%+v", *m) }
+func (*SignUpUserInput) ProtoMessage()    {}
+
+func (m *SignUpUserInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SignUpUserInput) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *SignUpUserInput) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *SignUpUserInput) GetPasswordConfirm() string {
+	if m != nil {
+		return m.PasswordConfirm
+	}
+	return ""
+}
+
+type SignUpUserResponse struct {
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *SignUpUserResponse) Reset()         { *m = SignUpUserResponse{} }
+func (m *SignUpUserResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*SignUpUserResponse) ProtoMessage()    {}
+
+func (m *SignUpUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+// SignInUserInput's Identifier accepts either the account's email or its
+// username, matching services.UserService.FindUserByEmailOrUsername.
+type SignInUserInput struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Password   string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (m *SignInUserInput) Reset()         { *m = SignInUserInput{} }
+func (m *SignInUserInput) String() string { return fmt.Sprintf("%+v", *m) }
+func (*SignInUserInput) ProtoMessage()    {}
+
+func (m *SignInUserInput) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *SignInUserInput) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type SignInUserResponse struct {
+	AccessToken  string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (m *SignInUserResponse) Reset()         { *m = SignInUserResponse{} }
+func (m *SignInUserResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*SignInUserResponse) ProtoMessage()    {}
+
+func (m *SignInUserResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+func (m *SignInUserResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type RefreshAccessTokenInput struct {
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (m *RefreshAccessTokenInput) Reset()         { *m = RefreshAccessTokenInput{} }
+func (m *RefreshAccessTokenInput) String() string { return fmt.Sprintf("%+v", *m) }
+func (*RefreshAccessTokenInput) ProtoMessage()    {}
+
+func (m *RefreshAccessTokenInput) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type RefreshAccessTokenResponse struct {
+	AccessToken  string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (m *RefreshAccessTokenResponse) Reset()         { *m = RefreshAccessTokenResponse{} }
+func (m *RefreshAccessTokenResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*RefreshAccessTokenResponse) ProtoMessage()    {}
+
+func (m *RefreshAccessTokenResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+func (m *RefreshAccessTokenResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type VerifyEmailInput struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *VerifyEmailInput) Reset()         { *m = VerifyEmailInput{} }
+func (m *VerifyEmailInput) String() string { return fmt.Sprintf("%+v", *m) }
+func (*VerifyEmailInput) ProtoMessage()    {}
+
+func (m *VerifyEmailInput) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	Verified bool `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (m *VerifyEmailResponse) Reset()         { *m = VerifyEmailResponse{} }
+func (m *VerifyEmailResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*VerifyEmailResponse) ProtoMessage()    {}
+
+func (m *VerifyEmailResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+// GetMeInput reads the caller's identity, which the unary interceptor resolves
+// from the authorization metadata, so it takes no fields of its own.
+type GetMeInput struct{}
+
+func (m *GetMeInput) Reset()         { *m = GetMeInput{} }
+func (m *GetMeInput) String() string { return fmt.Sprintf("%+v", *m) }
+func (*GetMeInput) ProtoMessage()    {}
+
+type GetMeResponse struct {
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *GetMeResponse) Reset()         { *m = GetMeResponse{} }
+func (m *GetMeResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*GetMeResponse) ProtoMessage()    {}
+
+func (m *GetMeResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type LogoutInput struct{}
+
+func (m *LogoutInput) Reset()         { *m = LogoutInput{} }
+func (m *LogoutInput) String() string { return fmt.Sprintf("%+v", *m) }
+func (*LogoutInput) ProtoMessage()    {}
+
+type LogoutResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
+func (m *LogoutResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*LogoutResponse) ProtoMessage()    {}
+
+func (m *LogoutResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
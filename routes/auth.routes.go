@@ -21,4 +21,14 @@ func (rc *AuthRouteController) AuthRoute(rg *gin.RouterGroup, userService servic
 	router.POST("/login", rc.authController.SignInUser)
 	router.GET("/refresh", rc.authController.RefreshAccessToken)
 	router.GET("/logout", middleware.DeserializeUser(userService), rc.authController.LogoutUser)
+	router.POST("/reauthenticate", middleware.DeserializeUser(userService), rc.authController.Reauthenticate)
+	router.GET("/verify/:code", rc.authController.VerifyEmail)
+	router.POST("/resend-verification", rc.authController.ResendVerification)
+}
+
+// UserRoute mounts account-mutation routes that require both an access
+// token and a recent reauthentication.
+func (rc *AuthRouteController) UserRoute(rg *gin.RouterGroup, userService services.UserService) {
+	router := rg.Group("/users")
+	router.PATCH("/password", middleware.DeserializeUser(userService), middleware.RequireReauth(userService), rc.authController.ChangePassword)
 }
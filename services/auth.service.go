@@ -1,8 +1,32 @@
 package services
 
-import "github.com/SarathLUN/auth-service-grpc-golang/models"
+import (
+	"time"
+
+	"github.com/SarathLUN/auth-service-grpc-golang/models"
+)
 
 type AuthService interface {
 	SignUpUser(*models.SignUpInput) (*models.DBResponse, error)
 	SignInUser(*models.SignInInput) (*models.DBResponse, error)
+	// StoreRefreshToken records a refresh token's jti for userID so it can
+	// later be validated or revoked, e.g. on refresh or logout.
+	StoreRefreshToken(userID string, jti string, ttl time.Duration) error
+	// IsRefreshTokenValid reports whether jti is still an active refresh
+	// token for userID.
+	IsRefreshTokenValid(userID string, jti string) (bool, error)
+	// RevokeRefreshToken removes jti from userID's active refresh tokens.
+	RevokeRefreshToken(userID string, jti string) error
+	// RevokeAllRefreshTokens removes every active refresh token for userID,
+	// e.g. after a password change.
+	RevokeAllRefreshTokens(userID string) error
+	// SendVerificationEmail generates a verification code for userID, stores
+	// it, and emails it to the user.
+	SendVerificationEmail(userID string, email string, name string) error
+	// ConsumeVerificationCode looks up the userID a verification code was
+	// issued for and invalidates the code, so it can only be used once.
+	ConsumeVerificationCode(code string) (string, error)
+	// AllowResendVerification reports whether userID may be sent another
+	// verification email, rate-limiting repeat requests.
+	AllowResendVerification(userID string) (bool, error)
 }
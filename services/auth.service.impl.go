@@ -3,25 +3,125 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/SarathLUN/auth-service-grpc-golang/models"
 	"github.com/SarathLUN/auth-service-grpc-golang/utils"
+	"github.com/go-redis/redis/v8"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// verificationCodeExpiredIn is how long a signup verification code or a
+// resend stays valid before the user must request a new one.
+const verificationCodeExpiredIn = 24 * time.Hour
+
+// resendVerificationCooldown rate-limits how often a user can ask for
+// another verification email.
+const resendVerificationCooldown = time.Minute
+
+// ErrEmailExists is returned by SignUpUser when the email's unique index
+// rejects the insert, so callers can distinguish it from other failures
+// without parsing the driver's error message.
+var ErrEmailExists = errors.New("user with this email already exists")
+
 type AuthServiceImpl struct {
-	collection *mongo.Collection
-	ctx        context.Context
+	collection  *mongo.Collection
+	ctx         context.Context
+	redisClient *redis.Client
+	mailer      Mailer
 }
 
-func NewAuthService(collection *mongo.Collection, ctx context.Context) AuthService {
+func NewAuthService(collection *mongo.Collection, redisClient *redis.Client, mailer Mailer, ctx context.Context) AuthService {
 	return &AuthServiceImpl{
-		collection: collection,
-		ctx:        ctx,
+		collection:  collection,
+		redisClient: redisClient,
+		mailer:      mailer,
+		ctx:         ctx,
+	}
+}
+
+// refreshTokenKey builds the Redis key a refresh token's jti is tracked
+// under for userID.
+func refreshTokenKey(userID, jti string) string {
+	return fmt.Sprintf("refresh:%s:%s", userID, jti)
+}
+
+func (uc *AuthServiceImpl) StoreRefreshToken(userID string, jti string, ttl time.Duration) error {
+	return uc.redisClient.Set(uc.ctx, refreshTokenKey(userID, jti), true, ttl).Err()
+}
+
+func (uc *AuthServiceImpl) IsRefreshTokenValid(userID string, jti string) (bool, error) {
+	_, err := uc.redisClient.Get(uc.ctx, refreshTokenKey(userID, jti)).Result()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (uc *AuthServiceImpl) RevokeRefreshToken(userID string, jti string) error {
+	return uc.redisClient.Del(uc.ctx, refreshTokenKey(userID, jti)).Err()
+}
+
+func (uc *AuthServiceImpl) RevokeAllRefreshTokens(userID string) error {
+	keys, err := uc.redisClient.Keys(uc.ctx, refreshTokenKey(userID, "*")).Result()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return uc.redisClient.Del(uc.ctx, keys...).Err()
+}
+
+// verificationCodeKey builds the Redis key a verification code is tracked
+// under, mapping the code back to the userID it was issued for.
+func verificationCodeKey(code string) string {
+	return fmt.Sprintf("verify:%s", code)
+}
+
+// resendVerificationKey builds the Redis key used to rate-limit resend
+// requests for userID.
+func resendVerificationKey(userID string) string {
+	return fmt.Sprintf("resend-verify:%s", userID)
+}
+
+func (uc *AuthServiceImpl) SendVerificationEmail(userID string, email string, name string) error {
+	code := strings.ReplaceAll(uuid.NewString(), "-", "")
+	if err := uc.redisClient.Set(uc.ctx, verificationCodeKey(code), userID, verificationCodeExpiredIn).Err(); err != nil {
+		return err
 	}
+	return uc.mailer.SendVerificationEmail(email, name, code)
+}
+
+func (uc *AuthServiceImpl) ConsumeVerificationCode(code string) (string, error) {
+	key := verificationCodeKey(code)
+	userID, err := uc.redisClient.Get(uc.ctx, key).Result()
+	if err == redis.Nil {
+		return "", errors.New("invalid or expired verification code")
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := uc.redisClient.Del(uc.ctx, key).Err(); err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+func (uc *AuthServiceImpl) AllowResendVerification(userID string) (bool, error) {
+	key := resendVerificationKey(userID)
+	ok, err := uc.redisClient.SetNX(uc.ctx, key, true, resendVerificationCooldown).Result()
+	if err != nil {
+		return false, err
+	}
+	return ok, nil
 }
 
 func (uc *AuthServiceImpl) SignUpUser(user *models.SignUpInput) (*models.DBResponse, error) {
@@ -29,27 +129,18 @@ func (uc *AuthServiceImpl) SignUpUser(user *models.SignUpInput) (*models.DBRespo
 	user.UpdatedAt = user.CreatedAt
 	user.Email = strings.ToLower(user.Email)
 	user.ConfirmPassword = ""
-	user.Verified = true
+	user.Verified = false
 	user.Role = "user"
 	hashedPassword, _ := utils.HashPassword(user.Password)
 	user.Password = hashedPassword
 	res, err := uc.collection.InsertOne(uc.ctx, &user)
 	if err != nil {
-		if er, ok := err.(mongo.WriteException); ok && er.WriteErrors[0].Code == 11000 {
-			return nil, errors.New("user with this email already is existed")
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ErrEmailExists
 		}
 		return nil, err
 	}
 
-	// create unique index for the email field
-	opt := options.Index()
-	opt.SetUnique(true)
-	index := mongo.IndexModel{Keys: bson.M{"email": 1}, Options: opt}
-
-	if _, err := uc.collection.Indexes().CreateOne(uc.ctx, index); err != nil {
-		return nil, errors.New("could not create index for email")
-	}
-
 	var newUser *models.DBResponse
 	query := bson.M{"_id": res.InsertedID}
 
@@ -57,6 +148,11 @@ func (uc *AuthServiceImpl) SignUpUser(user *models.SignUpInput) (*models.DBRespo
 	if err != nil {
 		return nil, err
 	}
+
+	// best-effort: the account already exists, so a delivery failure here
+	// shouldn't fail signup. The user can retry via /auth/resend-verification.
+	_ = uc.SendVerificationEmail(newUser.ID.Hex(), newUser.Email, newUser.Name)
+
 	return newUser, nil
 }
 
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+func newTestAuthService(t *testing.T) AuthService {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("could not start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	return NewAuthService(nil, redisClient, nil, context.Background())
+}
+
+func TestRefreshTokenStoreValidateRevoke(t *testing.T) {
+	auth := newTestAuthService(t)
+	const userID = "507f1f77bcf86cd799439011"
+	const jti = "a-jti"
+
+	valid, err := auth.IsRefreshTokenValid(userID, jti)
+	if err != nil {
+		t.Fatalf("IsRefreshTokenValid before store: %v", err)
+	}
+	if valid {
+		t.Fatal("expected token to be invalid before it was stored")
+	}
+
+	if err := auth.StoreRefreshToken(userID, jti, time.Minute); err != nil {
+		t.Fatalf("StoreRefreshToken: %v", err)
+	}
+
+	valid, err = auth.IsRefreshTokenValid(userID, jti)
+	if err != nil {
+		t.Fatalf("IsRefreshTokenValid after store: %v", err)
+	}
+	if !valid {
+		t.Fatal("expected token to be valid after it was stored")
+	}
+
+	if err := auth.RevokeRefreshToken(userID, jti); err != nil {
+		t.Fatalf("RevokeRefreshToken: %v", err)
+	}
+
+	valid, err = auth.IsRefreshTokenValid(userID, jti)
+	if err != nil {
+		t.Fatalf("IsRefreshTokenValid after revoke: %v", err)
+	}
+	if valid {
+		t.Fatal("expected token to be invalid after it was revoked")
+	}
+}
+
+func TestRevokeAllRefreshTokens(t *testing.T) {
+	auth := newTestAuthService(t)
+	const userID = "507f1f77bcf86cd799439011"
+	const otherUserID = "507f1f77bcf86cd799439099"
+
+	if err := auth.StoreRefreshToken(userID, "jti-1", time.Minute); err != nil {
+		t.Fatalf("StoreRefreshToken jti-1: %v", err)
+	}
+	if err := auth.StoreRefreshToken(userID, "jti-2", time.Minute); err != nil {
+		t.Fatalf("StoreRefreshToken jti-2: %v", err)
+	}
+	if err := auth.StoreRefreshToken(otherUserID, "jti-3", time.Minute); err != nil {
+		t.Fatalf("StoreRefreshToken jti-3: %v", err)
+	}
+
+	if err := auth.RevokeAllRefreshTokens(userID); err != nil {
+		t.Fatalf("RevokeAllRefreshTokens: %v", err)
+	}
+
+	for _, jti := range []string{"jti-1", "jti-2"} {
+		valid, err := auth.IsRefreshTokenValid(userID, jti)
+		if err != nil {
+			t.Fatalf("IsRefreshTokenValid(%s): %v", jti, err)
+		}
+		if valid {
+			t.Fatalf("expected %s to be revoked", jti)
+		}
+	}
+
+	valid, err := auth.IsRefreshTokenValid(otherUserID, "jti-3")
+	if err != nil {
+		t.Fatalf("IsRefreshTokenValid(jti-3): %v", err)
+	}
+	if !valid {
+		t.Fatal("expected jti-3 for an unrelated user to survive RevokeAllRefreshTokens")
+	}
+}
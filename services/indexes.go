@@ -0,0 +1,22 @@
+package services
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EnsureIndexes creates the indexes the auth service relies on. It must be
+// called once at startup, before the server accepts traffic, so the unique
+// email index exists before the first signup rather than racing it.
+func EnsureIndexes(ctx context.Context, collection *mongo.Collection) error {
+	opt := options.Index().
+		SetUnique(true).
+		SetCollation(&options.Collation{Locale: "en", Strength: 2})
+	index := mongo.IndexModel{Keys: bson.M{"email": 1}, Options: opt}
+
+	_, err := collection.Indexes().CreateOne(ctx, index)
+	return err
+}
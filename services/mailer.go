@@ -0,0 +1,6 @@
+package services
+
+// Mailer sends transactional emails on behalf of the auth service.
+type Mailer interface {
+	SendVerificationEmail(to, name, code string) error
+}
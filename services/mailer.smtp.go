@@ -0,0 +1,29 @@
+package services
+
+import (
+	"fmt"
+	"net/smtp"
+
+	"github.com/SarathLUN/auth-service-grpc-golang/config"
+)
+
+type SMTPMailer struct {
+	config config.Config
+}
+
+func NewSMTPMailer(config config.Config) Mailer {
+	return &SMTPMailer{config: config}
+}
+
+func (m *SMTPMailer) SendVerificationEmail(to, name, code string) error {
+	addr := fmt.Sprintf("%s:%d", m.config.SMTPHost, m.config.SMTPPort)
+	auth := smtp.PlainAuth("", m.config.SMTPUser, m.config.SMTPPass, m.config.SMTPHost)
+
+	verifyURL := fmt.Sprintf("%s/api/auth/verify/%s", m.config.AppBaseURL, code)
+
+	subject := "Verify your email address"
+	body := fmt.Sprintf("Hi %s,\r\n\r\nPlease verify your email by visiting:\r\n%s\r\n\r\nIf you didn't create an account, you can ignore this email.\r\n", name, verifyURL)
+	msg := []byte(fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s", m.config.SMTPFrom, to, subject, body))
+
+	return smtp.SendMail(addr, auth, m.config.SMTPFrom, []string{to}, msg)
+}
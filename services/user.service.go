@@ -5,4 +5,13 @@ import "github.com/SarathLUN/auth-service-grpc-golang/models"
 type UserService interface {
 	FindUserById(string) (*models.DBResponse, error)
 	FindUserByEmail(string) (*models.DBResponse, error)
+	// FindUserByEmailOrUsername looks a user up by either their email or
+	// their name, so SignInUser can accept a single identifier field.
+	FindUserByEmailOrUsername(identifier string) (*models.DBResponse, error)
+	// MarkUserVerified flips id's Verified flag after its verification code
+	// has been confirmed.
+	MarkUserVerified(id string) error
+	// UpdatePassword rehashes id's password to hashedPassword and bumps
+	// updated_at.
+	UpdatePassword(id string, hashedPassword string) error
 }
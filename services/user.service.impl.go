@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/SarathLUN/auth-service-grpc-golang/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type UserServiceImpl struct {
+	collection *mongo.Collection
+	ctx        context.Context
+}
+
+func NewUserService(collection *mongo.Collection, ctx context.Context) UserService {
+	return &UserServiceImpl{
+		collection: collection,
+		ctx:        ctx,
+	}
+}
+
+func (uc *UserServiceImpl) FindUserById(id string) (*models.DBResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *models.DBResponse
+	query := bson.M{"_id": oid}
+	err = uc.collection.FindOne(uc.ctx, query).Decode(&user)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (uc *UserServiceImpl) FindUserByEmail(email string) (*models.DBResponse, error) {
+	var user *models.DBResponse
+	query := bson.M{"email": strings.ToLower(email)}
+	err := uc.collection.FindOne(uc.ctx, query).Decode(&user)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (uc *UserServiceImpl) FindUserByEmailOrUsername(identifier string) (*models.DBResponse, error) {
+	var user *models.DBResponse
+	query := bson.M{"$or": []bson.M{
+		{"email": strings.ToLower(identifier)},
+		{"name": identifier},
+	}}
+	err := uc.collection.FindOne(uc.ctx, query).Decode(&user)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// MarkUserVerified flips id's Verified flag once its verification code has
+// been consumed.
+func (uc *UserServiceImpl) MarkUserVerified(id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{"$set": bson.M{"verified": true, "updated_at": time.Now()}}
+	_, err = uc.collection.UpdateOne(uc.ctx, bson.M{"_id": oid}, update)
+	return err
+}
+
+// UpdatePassword rehashes id's password to hashedPassword and bumps
+// updated_at.
+func (uc *UserServiceImpl) UpdatePassword(id string, hashedPassword string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{"$set": bson.M{"password": hashedPassword, "updated_at": time.Now()}}
+	_, err = uc.collection.UpdateOne(uc.ctx, bson.M{"_id": oid}, update)
+	return err
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+	"time"
+)
+
+// testKeyPair generates a throwaway RSA key pair, base64-PEM-encoded the
+// same way config.Config stores its access/refresh token keys, so
+// CreateToken/CreateReauthToken can sign against it without any config file.
+func testKeyPair(t *testing.T) (privateKey, publicKey string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	privPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	pubBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+	pubPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: pubBytes,
+	})
+
+	return base64.StdEncoding.EncodeToString(privPEM), base64.StdEncoding.EncodeToString(pubPEM)
+}
+
+func TestValidateReauthTokenRejectsPlainAccessToken(t *testing.T) {
+	privateKey, publicKey := testKeyPair(t)
+
+	accessToken, _, err := CreateToken(time.Minute, "507f1f77bcf86cd799439011", privateKey)
+	if err != nil {
+		t.Fatalf("CreateToken: %v", err)
+	}
+
+	if _, err := ValidateReauthToken(accessToken, publicKey); err == nil {
+		t.Fatal("expected a plain access token to be rejected by ValidateReauthToken")
+	}
+}
+
+func TestCreateAndValidateReauthToken(t *testing.T) {
+	privateKey, publicKey := testKeyPair(t)
+
+	reauthToken, err := CreateReauthToken(time.Minute, "507f1f77bcf86cd799439011", privateKey)
+	if err != nil {
+		t.Fatalf("CreateReauthToken: %v", err)
+	}
+
+	sub, err := ValidateReauthToken(reauthToken, publicKey)
+	if err != nil {
+		t.Fatalf("ValidateReauthToken: %v", err)
+	}
+	if sub != "507f1f77bcf86cd799439011" {
+		t.Fatalf("got sub %v, want 507f1f77bcf86cd799439011", sub)
+	}
+
+	if _, _, err := ValidateToken(reauthToken, publicKey); err != nil {
+		t.Fatalf("expected ValidateToken to still accept a reauth token's signature: %v", err)
+	}
+}